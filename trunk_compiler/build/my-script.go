@@ -8,5 +8,6 @@ import (
 
 func main() {
     value.Init()
-    debug.VarDump(runtime.NewArgs(value.NewInt(1), value.NewInt(2), value.NewInt(3))) 
-}
\ No newline at end of file
+    ctx := runtime.NewContext(runtime.NewStdOutput(runtime.Verbose))
+    debug.VarDump(ctx, runtime.NewArgs(value.NewInt(1), value.NewInt(2), value.NewInt(3)))
+}
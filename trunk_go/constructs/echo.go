@@ -1,13 +1,21 @@
 package constructs
 
 import (
-	"fmt"
-
+	"github.com/ryangjchandler/trunk/runtime"
+	"github.com/ryangjchandler/trunk/runtime/signature"
 	"github.com/ryangjchandler/trunk/value"
 )
 
-func Echo(values ...value.Value) {
+var echoSignature = signature.New("echo")
+
+func Echo(ctx *runtime.Context, values ...value.Value) (value.Value, error) {
+	if _, err := echoSignature.Bind(runtime.NewArgs(values...)); err != nil {
+		return nil, err
+	}
+
 	for _, value := range values {
-		fmt.Print(value.ToString())
+		ctx.Output.Write([]byte(value.ToString()))
 	}
+
+	return nil, nil
 }
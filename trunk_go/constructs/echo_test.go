@@ -0,0 +1,41 @@
+package constructs
+
+import (
+	"testing"
+
+	"github.com/ryangjchandler/trunk/runtime"
+	"github.com/ryangjchandler/trunk/testutil/golden"
+	"github.com/ryangjchandler/trunk/value"
+)
+
+func echoFixtureValues(t *testing.T, name string) []value.Value {
+	t.Helper()
+
+	switch name {
+	case "hello":
+		return []value.Value{value.NewString("hello, world")}
+	case "multiple":
+		return []value.Value{
+			value.NewString("x="),
+			value.NewInt(42),
+			value.NewString(", flag="),
+			value.NewBool(false),
+		}
+	default:
+		t.Fatalf("echo: no fixture case registered for %q", name)
+		return nil
+	}
+}
+
+func TestEcho(t *testing.T) {
+	golden.Run(t, "testdata", func(t *testing.T, name string) []byte {
+		out := runtime.NewBufferOutput(runtime.Normal)
+		ctx := runtime.NewContext(out)
+
+		if _, err := Echo(ctx, echoFixtureValues(t, name)...); err != nil {
+			t.Fatalf("Echo: %v", err)
+		}
+
+		return []byte(out.String())
+	})
+}
@@ -0,0 +1,27 @@
+package debug
+
+import (
+	"github.com/ryangjchandler/trunk/runtime"
+	"github.com/ryangjchandler/trunk/runtime/signature"
+	"github.com/ryangjchandler/trunk/value"
+)
+
+var traceSignature = signature.New("trace")
+
+// Trace emits args only at the Debug verbosity level, for diagnostics that
+// are too noisy for VarDump's Verbose level.
+func Trace(ctx *runtime.Context, args runtime.Args) (value.Value, error) {
+	if _, err := traceSignature.Bind(args); err != nil {
+		return nil, err
+	}
+
+	if ctx.Output.Verbosity() < runtime.Debug {
+		return nil, nil
+	}
+
+	for _, value := range args.All() {
+		ctx.Output.Writeln(value.Dump())
+	}
+
+	return nil, nil
+}
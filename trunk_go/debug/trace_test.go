@@ -0,0 +1,52 @@
+package debug
+
+import (
+	"testing"
+
+	"github.com/ryangjchandler/trunk/runtime"
+	"github.com/ryangjchandler/trunk/testutil/golden"
+	"github.com/ryangjchandler/trunk/value"
+)
+
+func traceFixtureArgs(t *testing.T, name string) runtime.Args {
+	t.Helper()
+
+	switch name {
+	case "scalar":
+		return runtime.NewArgs(value.NewInt(7))
+	default:
+		t.Fatalf("trace: no fixture case registered for %q", name)
+		return runtime.Args{}
+	}
+}
+
+func TestTrace(t *testing.T) {
+	golden.Run(t, "testdata/trace", func(t *testing.T, name string) []byte {
+		out := runtime.NewBufferOutput(runtime.Debug)
+		ctx := runtime.NewContext(out)
+
+		if _, err := Trace(ctx, traceFixtureArgs(t, name)); err != nil {
+			t.Fatalf("Trace: %v", err)
+		}
+
+		return []byte(out.String())
+	})
+}
+
+// TestTraceSuppressedBelowDebug exercises the verbosity gating chunk0-4
+// introduced: Trace must stay silent below Debug, including at Verbose
+// (where VarDump would already be emitting).
+func TestTraceSuppressedBelowDebug(t *testing.T) {
+	for _, level := range []runtime.Level{runtime.Quiet, runtime.Normal, runtime.Verbose, runtime.VeryVerbose} {
+		out := runtime.NewBufferOutput(level)
+		ctx := runtime.NewContext(out)
+
+		if _, err := Trace(ctx, runtime.NewArgs(value.NewInt(1))); err != nil {
+			t.Fatalf("Trace: %v", err)
+		}
+
+		if got := out.String(); got != "" {
+			t.Fatalf("Trace at %s: expected no output, got %q", level, got)
+		}
+	}
+}
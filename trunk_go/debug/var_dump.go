@@ -1,13 +1,25 @@
 package debug
 
 import (
-	"fmt"
-
 	"github.com/ryangjchandler/trunk/runtime"
+	"github.com/ryangjchandler/trunk/runtime/signature"
+	"github.com/ryangjchandler/trunk/value"
 )
 
-func VarDump(args runtime.Args) {
+var varDumpSignature = signature.New("var_dump")
+
+func VarDump(ctx *runtime.Context, args runtime.Args) (value.Value, error) {
+	if _, err := varDumpSignature.Bind(args); err != nil {
+		return nil, err
+	}
+
+	if ctx.Output.Verbosity() < runtime.Verbose {
+		return nil, nil
+	}
+
 	for _, value := range args.All() {
-		fmt.Printf("%s\n", value.Dump())
+		ctx.Output.Writeln(value.Dump())
 	}
+
+	return nil, nil
 }
@@ -0,0 +1,62 @@
+package debug
+
+import (
+	"testing"
+
+	"github.com/ryangjchandler/trunk/runtime"
+	"github.com/ryangjchandler/trunk/testutil/golden"
+	"github.com/ryangjchandler/trunk/value"
+)
+
+func varDumpFixtureArgs(t *testing.T, name string) runtime.Args {
+	t.Helper()
+
+	switch name {
+	case "scalars":
+		return runtime.NewArgs(value.NewInt(1), value.NewString("two"), value.NewBool(true), value.NewNull())
+	case "nested_array":
+		inner := value.NewArray()
+		inner.Push(value.NewString("b"))
+
+		outer := value.NewArray()
+		outer.Push(value.NewInt(1))
+		outer.Push(inner)
+
+		return runtime.NewArgs(outer)
+	case "floats":
+		return runtime.NewArgs(value.NewFloat(1.5), value.NewFloat(10), value.NewFloat(1.0e20))
+	default:
+		t.Fatalf("var_dump: no fixture case registered for %q", name)
+		return runtime.Args{}
+	}
+}
+
+func TestVarDump(t *testing.T) {
+	golden.Run(t, "testdata", func(t *testing.T, name string) []byte {
+		out := runtime.NewBufferOutput(runtime.Verbose)
+		ctx := runtime.NewContext(out)
+
+		if _, err := VarDump(ctx, varDumpFixtureArgs(t, name)); err != nil {
+			t.Fatalf("VarDump: %v", err)
+		}
+
+		return []byte(out.String())
+	})
+}
+
+// TestVarDumpSuppressedBelowVerbose exercises the verbosity gating chunk0-4
+// introduced: VarDump must stay silent below Verbose.
+func TestVarDumpSuppressedBelowVerbose(t *testing.T) {
+	for _, level := range []runtime.Level{runtime.Quiet, runtime.Normal} {
+		out := runtime.NewBufferOutput(level)
+		ctx := runtime.NewContext(out)
+
+		if _, err := VarDump(ctx, runtime.NewArgs(value.NewInt(1))); err != nil {
+			t.Fatalf("VarDump: %v", err)
+		}
+
+		if got := out.String(); got != "" {
+			t.Fatalf("VarDump at %s: expected no output, got %q", level, got)
+		}
+	}
+}
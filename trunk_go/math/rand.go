@@ -1,37 +1,47 @@
 package math
 
 import (
+	"fmt"
 	"math/rand"
 	"time"
 
 	"github.com/ryangjchandler/trunk/runtime"
+	"github.com/ryangjchandler/trunk/runtime/signature"
 	"github.com/ryangjchandler/trunk/value"
 )
 
-func Rand(args runtime.Args) value.Value {
+var randSignature = signature.New("rand",
+	signature.Param{Name: "min", Kind: value.KindInt},
+	signature.Param{Name: "max", Kind: value.KindInt},
+)
+
+func Rand(args runtime.Args) (value.Value, error) {
 	if args.IsEmpty() {
-		return value.NewInt(rand.Int())
+		return value.NewInt(rand.Int()), nil
 	}
 
-	if args.Count() < 2 {
-		panic("todo: add in error here")
+	bound, err := randSignature.Bind(args)
+	if err != nil {
+		return nil, err
 	}
 
-	min := args.At(0)
-	max := args.At(1)
+	min := bound.Int("min")
+	max := bound.Int("max")
 
-	if !min.IsInt() {
-		panic("rand(): argument 1 ($min) must be of type int")
+	if min > max {
+		return nil, &runtime.ValueError{
+			Func:     "rand",
+			ArgIndex: 1,
+			ArgName:  "max",
+			Message:  fmt.Sprintf("must be greater than or equal to min (%d), %d given", min, max),
+		}
 	}
 
-	if !max.IsInt() {
-		panic("rand(): argument 2 ($max) must be of type int")
+	if min == max {
+		return value.NewInt(min), nil
 	}
 
-	min_i := min.ToInt()
-	max_i := max.ToInt()
-
-	return value.NewInt(rand.Intn(max_i-min_i) + min_i)
+	return value.NewInt(rand.Intn(max-min) + min), nil
 }
 
 func init() {
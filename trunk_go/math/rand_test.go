@@ -0,0 +1,37 @@
+package math
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ryangjchandler/trunk/runtime"
+	"github.com/ryangjchandler/trunk/testutil/golden"
+	"github.com/ryangjchandler/trunk/value"
+)
+
+// randFixtureArgs uses a single-value range so the result is deterministic
+// regardless of seed.
+func randFixtureArgs(t *testing.T, name string) runtime.Args {
+	t.Helper()
+
+	switch name {
+	case "equal_bounds":
+		return runtime.NewArgs(value.NewInt(5), value.NewInt(5))
+	default:
+		t.Fatalf("rand: no fixture case registered for %q", name)
+		return runtime.Args{}
+	}
+}
+
+func TestRand(t *testing.T) {
+	golden.Run(t, "testdata", func(t *testing.T, name string) []byte {
+		rand.Seed(42)
+
+		result, err := Rand(randFixtureArgs(t, name))
+		if err != nil {
+			t.Fatalf("Rand: %v", err)
+		}
+
+		return []byte(result.Dump() + "\n")
+	})
+}
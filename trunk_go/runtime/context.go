@@ -0,0 +1,12 @@
+package runtime
+
+// Context is threaded through every builtin, giving it access to the
+// runtime's output sink rather than reaching for fmt.Print/os.Stdout
+// directly.
+type Context struct {
+	Output Output
+}
+
+func NewContext(output Output) *Context {
+	return &Context{Output: output}
+}
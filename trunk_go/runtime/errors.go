@@ -0,0 +1,32 @@
+package runtime
+
+import "fmt"
+
+// TypeError reports that an argument passed to a builtin did not have the
+// expected type, mirroring the message PHP itself raises for the same
+// mistake (e.g. "rand(): Argument #1 ($min) must be of type int, string given").
+type TypeError struct {
+	Func     string
+	ArgIndex int
+	ArgName  string
+	Expected string
+	Got      string
+}
+
+func (e *TypeError) Error() string {
+	return fmt.Sprintf("%s(): Argument #%d ($%s) must be of type %s, %s given", e.Func, e.ArgIndex+1, e.ArgName, e.Expected, e.Got)
+}
+
+// ValueError reports that an argument passed to a builtin had the right
+// type but an unacceptable value, mirroring PHP's ValueError (e.g. passing
+// a $min greater than $max to rand()).
+type ValueError struct {
+	Func     string
+	ArgIndex int
+	ArgName  string
+	Message  string
+}
+
+func (e *ValueError) Error() string {
+	return fmt.Sprintf("%s(): Argument #%d ($%s) %s", e.Func, e.ArgIndex+1, e.ArgName, e.Message)
+}
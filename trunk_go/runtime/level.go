@@ -0,0 +1,33 @@
+package runtime
+
+import "strconv"
+
+// Level controls how much output a runtime.Context's Output should emit,
+// mirroring the -v/-vv/-vvv verbosity staircase used by mature CLI
+// frameworks.
+type Level int
+
+const (
+	Quiet Level = iota
+	Normal
+	Verbose
+	VeryVerbose
+	Debug
+)
+
+func (l Level) String() string {
+	switch l {
+	case Quiet:
+		return "Quiet"
+	case Normal:
+		return "Normal"
+	case Verbose:
+		return "Verbose"
+	case VeryVerbose:
+		return "VeryVerbose"
+	case Debug:
+		return "Debug"
+	default:
+		return "Level(" + strconv.Itoa(int(l)) + ")"
+	}
+}
@@ -0,0 +1,14 @@
+package runtime
+
+import "github.com/ryangjchandler/trunk/value"
+
+// Must unwraps a (value.Value, error) result, panicking if err is non-nil.
+// It exists for callers that want the old panic-on-failure behaviour instead
+// of propagating the error.
+func Must(v value.Value, err error) value.Value {
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
@@ -0,0 +1,63 @@
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+)
+
+// Output is where builtins like Echo and VarDump send their output, instead
+// of writing to stdout directly. This is what lets the runtime be embedded
+// in tests, web handlers, or REPLs.
+type Output interface {
+	Write(p []byte) (int, error)
+	Writeln(a ...interface{})
+	Verbosity() Level
+}
+
+// StdOutput writes to os.Stdout, and is what a standalone CLI run uses.
+type StdOutput struct {
+	level Level
+}
+
+func NewStdOutput(level Level) *StdOutput {
+	return &StdOutput{level}
+}
+
+func (o *StdOutput) Write(p []byte) (int, error) {
+	return os.Stdout.Write(p)
+}
+
+func (o *StdOutput) Writeln(a ...interface{}) {
+	fmt.Fprintln(os.Stdout, a...)
+}
+
+func (o *StdOutput) Verbosity() Level {
+	return o.level
+}
+
+// BufferOutput collects output in memory, for use in tests.
+type BufferOutput struct {
+	level Level
+	buf   bytes.Buffer
+}
+
+func NewBufferOutput(level Level) *BufferOutput {
+	return &BufferOutput{level: level}
+}
+
+func (o *BufferOutput) Write(p []byte) (int, error) {
+	return o.buf.Write(p)
+}
+
+func (o *BufferOutput) Writeln(a ...interface{}) {
+	fmt.Fprintln(&o.buf, a...)
+}
+
+func (o *BufferOutput) Verbosity() Level {
+	return o.level
+}
+
+func (o *BufferOutput) String() string {
+	return o.buf.String()
+}
@@ -0,0 +1,186 @@
+// Package signature lets a builtin declare its parameters once - name,
+// expected kind, optional default, optional validators - instead of every
+// builtin hand-rolling its own args.Count()/Kind() checks and panic
+// messages.
+package signature
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ryangjchandler/trunk/runtime"
+	"github.com/ryangjchandler/trunk/value"
+)
+
+// Validator runs an additional check against an already-bound argument,
+// beyond the built-in "is this the right Kind" check. Implementations live
+// under runtime/signature/validator.
+type Validator interface {
+	Validate(v value.Value) error
+}
+
+// Param describes a single parameter a builtin accepts.
+type Param struct {
+	Name string
+	Kind value.Kind
+	// Default is used when the caller didn't pass this argument. A nil
+	// Default means the parameter is required.
+	Default    value.Value
+	Validators []Validator
+}
+
+// Signature is a declarative description of a builtin's parameters.
+type Signature struct {
+	Func   string
+	Params []Param
+}
+
+// New builds a Signature for the builtin named fn.
+func New(fn string, params ...Param) Signature {
+	return Signature{Func: fn, Params: params}
+}
+
+// Bound holds the resolved argument values after a successful Bind.
+type Bound struct {
+	values map[string]value.Value
+}
+
+func (b Bound) Value(name string) value.Value {
+	return b.values[name]
+}
+
+func (b Bound) Int(name string) int {
+	i, _ := b.values[name].ToInt()
+	return i
+}
+
+func (b Bound) String(name string) string {
+	return b.values[name].ToString()
+}
+
+// Bind validates args against the signature, returning a Bound on success
+// or a *ValidationError aggregating every failing argument.
+func (s Signature) Bind(args runtime.Args) (Bound, error) {
+	bound := Bound{values: map[string]value.Value{}}
+	var failures []error
+
+	for i, param := range s.Params {
+		var v value.Value
+
+		switch {
+		case i < args.Count():
+			v = args.At(i)
+		case param.Default != nil:
+			v = param.Default
+		default:
+			failures = append(failures, &ArgError{
+				Func:     s.Func,
+				ArgIndex: i,
+				ArgName:  param.Name,
+				Message:  "is required",
+			})
+			continue
+		}
+
+		if param.Kind != value.KindMixed && v.Kind() != param.Kind {
+			coerced, err := coerce(v, param.Kind)
+			if err != nil {
+				failures = append(failures, &runtime.TypeError{
+					Func:     s.Func,
+					ArgIndex: i,
+					ArgName:  param.Name,
+					Expected: param.Kind.String(),
+					Got:      v.Kind().String(),
+				})
+				continue
+			}
+
+			v = coerced
+		}
+
+		for _, validator := range param.Validators {
+			if err := validator.Validate(v); err != nil {
+				failures = append(failures, &ArgError{
+					Func:     s.Func,
+					ArgIndex: i,
+					ArgName:  param.Name,
+					Message:  err.Error(),
+				})
+			}
+		}
+
+		bound.values[param.Name] = v
+	}
+
+	if len(failures) > 0 {
+		return Bound{}, &ValidationError{Errors: failures}
+	}
+
+	return bound, nil
+}
+
+// coerce converts v to kind using PHP's juggling rules (value.Value's
+// ToInt/ToFloat/ToBool/ToString/ToArray), rather than rejecting any value
+// whose Kind doesn't already match. It fails for a target kind that has no
+// coercion (null), and when the conversion itself returns an error, e.g. a
+// numeric string that overflows int.
+func coerce(v value.Value, kind value.Kind) (value.Value, error) {
+	switch kind {
+	case value.KindInt:
+		i, err := v.ToInt()
+		if err != nil {
+			return nil, err
+		}
+		return value.NewInt(i), nil
+	case value.KindFloat:
+		f, err := v.ToFloat()
+		if err != nil {
+			return nil, err
+		}
+		return value.NewFloat(f), nil
+	case value.KindBool:
+		b, err := v.ToBool()
+		if err != nil {
+			return nil, err
+		}
+		return value.NewBool(b), nil
+	case value.KindString:
+		return value.NewString(v.ToString()), nil
+	case value.KindArray:
+		a, err := v.ToArray()
+		if err != nil {
+			return nil, err
+		}
+		return a, nil
+	default:
+		return nil, fmt.Errorf("cannot coerce %s to %s", v.Kind(), kind)
+	}
+}
+
+// ArgError reports a single failing argument in PHP's own error style, e.g.
+// "trim(): Argument #1 ($string) must not be blank".
+type ArgError struct {
+	Func     string
+	ArgIndex int
+	ArgName  string
+	Message  string
+}
+
+func (e *ArgError) Error() string {
+	return fmt.Sprintf("%s(): Argument #%d ($%s) %s", e.Func, e.ArgIndex+1, e.ArgName, e.Message)
+}
+
+// ValidationError aggregates every argument that failed to bind, so callers
+// can report all of them at once rather than stopping at the first.
+type ValidationError struct {
+	Errors []error
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
@@ -0,0 +1,57 @@
+package signature_test
+
+import (
+	"testing"
+
+	"github.com/ryangjchandler/trunk/runtime"
+	"github.com/ryangjchandler/trunk/runtime/signature"
+	"github.com/ryangjchandler/trunk/runtime/signature/validator"
+	"github.com/ryangjchandler/trunk/value"
+)
+
+func TestBindRequired(t *testing.T) {
+	sig := signature.New("greet", signature.Param{Name: "name", Kind: value.KindString})
+
+	_, err := sig.Bind(runtime.NewArgs())
+	if err == nil {
+		t.Fatal("Bind: expected error for missing required argument")
+	}
+
+	want := `greet(): Argument #1 ($name) is required`
+	if err.Error() != want {
+		t.Fatalf("Bind: error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestBindValidator(t *testing.T) {
+	sig := signature.New("sort",
+		signature.Param{Name: "direction", Kind: value.KindString, Validators: []signature.Validator{validator.Enum("asc", "desc")}},
+	)
+
+	_, err := sig.Bind(runtime.NewArgs(value.NewString("sideways")))
+	if err == nil {
+		t.Fatal("Bind: expected error for disallowed value")
+	}
+
+	want := `sort(): Argument #1 ($direction) must be one of asc, desc, "sideways" given`
+	if err.Error() != want {
+		t.Fatalf("Bind: error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestBindAggregatesFailures(t *testing.T) {
+	sig := signature.New("between",
+		signature.Param{Name: "value", Kind: value.KindInt, Validators: []signature.Validator{validator.Range(1, 10)}},
+		signature.Param{Name: "label", Kind: value.KindString, Validators: []signature.Validator{validator.NotBlank()}},
+	)
+
+	_, err := sig.Bind(runtime.NewArgs(value.NewInt(99), value.NewString("")))
+	if err == nil {
+		t.Fatal("Bind: expected an aggregated error")
+	}
+
+	want := `between(): Argument #1 ($value) must be between 1 and 10, 99 given; between(): Argument #2 ($label) must not be blank`
+	if err.Error() != want {
+		t.Fatalf("Bind: error = %q, want %q", err.Error(), want)
+	}
+}
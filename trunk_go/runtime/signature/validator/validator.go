@@ -0,0 +1,69 @@
+// Package validator provides composable checks for use with
+// runtime/signature.Param.Validators.
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ryangjchandler/trunk/runtime/signature"
+	"github.com/ryangjchandler/trunk/value"
+)
+
+type notBlank struct{}
+
+// NotBlank rejects a value whose string representation is empty.
+func NotBlank() signature.Validator {
+	return notBlank{}
+}
+
+func (notBlank) Validate(v value.Value) error {
+	if v.ToString() == "" {
+		return fmt.Errorf("must not be blank")
+	}
+
+	return nil
+}
+
+type enum struct {
+	allowed []string
+}
+
+// Enum rejects a value whose string representation isn't one of allowed.
+func Enum(allowed ...string) signature.Validator {
+	return enum{allowed}
+}
+
+func (e enum) Validate(v value.Value) error {
+	s := v.ToString()
+
+	for _, allowed := range e.allowed {
+		if s == allowed {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("must be one of %s, \"%s\" given", strings.Join(e.allowed, ", "), s)
+}
+
+type rangeValidator struct {
+	min, max int
+}
+
+// Range rejects an int value outside of [min, max].
+func Range(min, max int) signature.Validator {
+	return rangeValidator{min, max}
+}
+
+func (r rangeValidator) Validate(v value.Value) error {
+	i, err := v.ToInt()
+	if err != nil {
+		return err
+	}
+
+	if i < r.min || i > r.max {
+		return fmt.Errorf("must be between %d and %d, %d given", r.min, r.max, i)
+	}
+
+	return nil
+}
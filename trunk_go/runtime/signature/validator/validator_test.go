@@ -0,0 +1,55 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/ryangjchandler/trunk/value"
+)
+
+func TestNotBlank(t *testing.T) {
+	v := NotBlank()
+
+	if err := v.Validate(value.NewString("hello")); err != nil {
+		t.Fatalf("NotBlank: unexpected error for non-blank string: %v", err)
+	}
+
+	if err := v.Validate(value.NewString("")); err == nil {
+		t.Fatal("NotBlank: expected error for blank string")
+	}
+}
+
+func TestEnum(t *testing.T) {
+	v := Enum("asc", "desc")
+
+	if err := v.Validate(value.NewString("asc")); err != nil {
+		t.Fatalf("Enum: unexpected error for allowed value: %v", err)
+	}
+
+	err := v.Validate(value.NewString("sideways"))
+	if err == nil {
+		t.Fatal("Enum: expected error for disallowed value")
+	}
+
+	want := `must be one of asc, desc, "sideways" given`
+	if err.Error() != want {
+		t.Fatalf("Enum: error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestRange(t *testing.T) {
+	v := Range(1, 10)
+
+	if err := v.Validate(value.NewInt(5)); err != nil {
+		t.Fatalf("Range: unexpected error for in-range value: %v", err)
+	}
+
+	err := v.Validate(value.NewInt(11))
+	if err == nil {
+		t.Fatal("Range: expected error for out-of-range value")
+	}
+
+	want := "must be between 1 and 10, 11 given"
+	if err.Error() != want {
+		t.Fatalf("Range: error = %q, want %q", err.Error(), want)
+	}
+}
@@ -0,0 +1,103 @@
+// Package golden implements a small filecmp-style fixture harness: compare
+// captured output line by line against an expected file, reporting the
+// first mismatch with both sides quoted. Set UPDATE_GOLDEN=1 to rewrite the
+// expected files instead of comparing against them.
+//
+// This tree has no .trunk parser yet, so a fixture's .trunk file is
+// descriptive only - callers resolve a fixture name to actual arguments
+// themselves (typically a small switch statement) and pass the captured
+// output to Run or Compare.
+package golden
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// Fixtures returns the base names (without extension) of every .trunk
+// fixture file found in dir, sorted for stable test output.
+func Fixtures(t *testing.T, dir string) []string {
+	t.Helper()
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.trunk"))
+	if err != nil {
+		t.Fatalf("golden: failed to glob %s: %v", dir, err)
+	}
+
+	names := make([]string, 0, len(matches))
+	for _, match := range matches {
+		names = append(names, strings.TrimSuffix(filepath.Base(match), ".trunk"))
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Run executes a fixture-driven golden test: for every .trunk file found in
+// dir, it runs a subtest named after the fixture, calling exec to produce
+// the actual output and comparing it against the fixture's sibling .out
+// file.
+func Run(t *testing.T, dir string, exec func(t *testing.T, name string) []byte) {
+	t.Helper()
+
+	for _, name := range Fixtures(t, dir) {
+		t.Run(name, func(t *testing.T) {
+			Compare(t, filepath.Join(dir, name+".out"), exec(t, name))
+		})
+	}
+}
+
+// Compare checks got against the contents of the golden file at path,
+// failing t at the first differing line. If UPDATE_GOLDEN=1 is set, path is
+// overwritten with got instead.
+func Compare(t *testing.T, path string, got []byte) {
+	t.Helper()
+
+	if os.Getenv("UPDATE_GOLDEN") == "1" {
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatalf("golden: failed to update %s: %v", path, err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("golden: failed to read %s: %v", path, err)
+	}
+
+	wantLines := lines(want)
+	gotLines := lines(got)
+
+	max := len(wantLines)
+	if len(gotLines) > max {
+		max = len(gotLines)
+	}
+
+	for i := 0; i < max; i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+
+		if w != g {
+			t.Fatalf("%s: mismatch at line %d:\n  want: %q\n  got:  %q", path, i+1, w, g)
+		}
+	}
+}
+
+func lines(b []byte) []string {
+	s := strings.TrimSuffix(string(b), "\n")
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, "\n")
+}
@@ -0,0 +1,111 @@
+package value
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Array is an ordered map from PHP's int|string keys to Value, preserving
+// insertion order the way PHP arrays do.
+type Array struct {
+	keys      []interface{}
+	values    map[interface{}]Value
+	nextIndex int
+}
+
+func NewArray() *Array {
+	return &Array{values: map[interface{}]Value{}}
+}
+
+// Set assigns value under key, which must be an int or a string.
+func (a *Array) Set(key interface{}, value Value) {
+	if _, exists := a.values[key]; !exists {
+		a.keys = append(a.keys, key)
+	}
+
+	a.values[key] = value
+
+	if intKey, ok := key.(int); ok && intKey >= a.nextIndex {
+		a.nextIndex = intKey + 1
+	}
+}
+
+// Push appends value under the next available integer key, mirroring PHP's
+// `$array[] = $value`.
+func (a *Array) Push(value Value) {
+	a.Set(a.nextIndex, value)
+}
+
+func (a *Array) Get(key interface{}) (Value, bool) {
+	value, ok := a.values[key]
+	return value, ok
+}
+
+func (a *Array) Len() int {
+	return len(a.keys)
+}
+
+func (a *Array) Keys() []interface{} {
+	return a.keys
+}
+
+func (a *Array) Kind() Kind {
+	return KindArray
+}
+
+func (a *Array) ToString() string {
+	return "Array"
+}
+
+func (a *Array) ToInt() (int, error) {
+	if len(a.keys) == 0 {
+		return 0, nil
+	}
+
+	return 1, nil
+}
+
+func (a *Array) ToFloat() (float64, error) {
+	i, _ := a.ToInt()
+	return float64(i), nil
+}
+
+func (a *Array) ToBool() (bool, error) {
+	return len(a.keys) > 0, nil
+}
+
+func (a *Array) ToArray() (*Array, error) {
+	return a, nil
+}
+
+func (a *Array) Dump() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "array(%d) {\n", len(a.keys))
+
+	for _, key := range a.keys {
+		fmt.Fprintf(&b, "  [%s]=>\n", dumpKey(key))
+
+		for _, line := range strings.Split(a.values[key].Dump(), "\n") {
+			b.WriteString("  ")
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("}")
+
+	return b.String()
+}
+
+func dumpKey(key interface{}) string {
+	switch k := key.(type) {
+	case int:
+		return strconv.Itoa(k)
+	case string:
+		return fmt.Sprintf("%q", k)
+	default:
+		return fmt.Sprintf("%v", k)
+	}
+}
@@ -0,0 +1,52 @@
+package value
+
+type Bool struct {
+	value bool
+}
+
+func NewBool(value bool) *Bool {
+	return &Bool{value}
+}
+
+func (b *Bool) Kind() Kind {
+	return KindBool
+}
+
+func (b *Bool) ToString() string {
+	if b.value {
+		return "1"
+	}
+
+	return ""
+}
+
+func (b *Bool) ToInt() (int, error) {
+	if b.value {
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+func (b *Bool) ToFloat() (float64, error) {
+	i, _ := b.ToInt()
+	return float64(i), nil
+}
+
+func (b *Bool) ToBool() (bool, error) {
+	return b.value, nil
+}
+
+func (b *Bool) ToArray() (*Array, error) {
+	arr := NewArray()
+	arr.Push(b)
+	return arr, nil
+}
+
+func (b *Bool) Dump() string {
+	if b.value {
+		return "bool(true)"
+	}
+
+	return "bool(false)"
+}
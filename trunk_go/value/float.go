@@ -0,0 +1,92 @@
+package value
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type Float struct {
+	value float64
+}
+
+func NewFloat(value float64) *Float {
+	return &Float{value}
+}
+
+func (f *Float) Kind() Kind {
+	return KindFloat
+}
+
+func (f *Float) ToString() string {
+	return strconv.FormatFloat(f.value, 'G', -1, 64)
+}
+
+func (f *Float) ToInt() (int, error) {
+	return int(f.value), nil
+}
+
+func (f *Float) ToFloat() (float64, error) {
+	return f.value, nil
+}
+
+func (f *Float) ToBool() (bool, error) {
+	return f.value != 0, nil
+}
+
+func (f *Float) ToArray() (*Array, error) {
+	arr := NewArray()
+	arr.Push(f)
+	return arr, nil
+}
+
+func (f *Float) Dump() string {
+	return fmt.Sprintf("float(%s)", dumpFloat(f.value))
+}
+
+// dumpFloat renders f the way PHP's var_dump does under
+// serialize_precision=-1: the shortest round-trippable decimal, with a
+// signed zero, and exponential notation written as "1.0E+20"/"1.0E-5"
+// rather than Go's "1e+20"/"1e-05".
+func dumpFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NAN"
+	case math.IsInf(f, 1):
+		return "INF"
+	case math.IsInf(f, -1):
+		return "-INF"
+	case f == 0:
+		if math.Signbit(f) {
+			return "-0"
+		}
+		return "0"
+	}
+
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+
+	eIdx := strings.IndexByte(s, 'e')
+	if eIdx == -1 {
+		return s
+	}
+
+	mantissa := s[:eIdx]
+	if !strings.Contains(mantissa, ".") {
+		mantissa += ".0"
+	}
+
+	exponent := s[eIdx+1:]
+	sign := "+"
+	if exponent[0] == '+' || exponent[0] == '-' {
+		sign = string(exponent[0])
+		exponent = exponent[1:]
+	}
+
+	exponent = strings.TrimLeft(exponent, "0")
+	if exponent == "" {
+		exponent = "0"
+	}
+
+	return mantissa + "E" + sign + exponent
+}
@@ -0,0 +1,29 @@
+package value
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloatDump(t *testing.T) {
+	cases := []struct {
+		name  string
+		value float64
+		want  string
+	}{
+		{"integer-valued", 10, "float(10)"},
+		{"fraction", 1.5, "float(1.5)"},
+		{"large-exponent", 1e20, "float(1.0E+20)"},
+		{"small-exponent", 0.00001, "float(1.0E-5)"},
+		{"negative-zero", math.Copysign(0, -1), "float(-0)"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewFloat(tc.value).Dump()
+			if got != tc.want {
+				t.Fatalf("Dump() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
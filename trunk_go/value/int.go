@@ -13,20 +13,30 @@ func NewInt(value int) *Int {
 	return &Int{value}
 }
 
+func (i *Int) Kind() Kind {
+	return KindInt
+}
+
 func (i *Int) ToString() string {
 	return strconv.Itoa(i.value)
 }
 
-func (i *Int) ToInt() int {
-	return i.value
+func (i *Int) ToInt() (int, error) {
+	return i.value, nil
+}
+
+func (i *Int) ToFloat() (float64, error) {
+	return float64(i.value), nil
 }
 
-func (i *Int) IsString() bool {
-	return false
+func (i *Int) ToBool() (bool, error) {
+	return i.value != 0, nil
 }
 
-func (i *Int) IsInt() bool {
-	return true
+func (i *Int) ToArray() (*Array, error) {
+	arr := NewArray()
+	arr.Push(i)
+	return arr, nil
 }
 
 func (i *Int) Dump() string {
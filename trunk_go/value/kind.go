@@ -0,0 +1,41 @@
+package value
+
+// Kind identifies the concrete PHP type backing a Value, replacing the old
+// one-predicate-per-type (IsString, IsInt, ...) approach now that the value
+// subsystem has grown beyond two types.
+type Kind int
+
+const (
+	KindInt Kind = iota
+	KindFloat
+	KindString
+	KindBool
+	KindNull
+	KindArray
+
+	// KindMixed accepts any Kind and is used by call sites (e.g. builtin
+	// parameter declarations) that don't want to constrain the type of a
+	// value, matching PHP's `mixed` type.
+	KindMixed
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindInt:
+		return "int"
+	case KindFloat:
+		return "float"
+	case KindString:
+		return "string"
+	case KindBool:
+		return "bool"
+	case KindNull:
+		return "null"
+	case KindArray:
+		return "array"
+	case KindMixed:
+		return "mixed"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,35 @@
+package value
+
+type Null struct{}
+
+func NewNull() *Null {
+	return &Null{}
+}
+
+func (n *Null) Kind() Kind {
+	return KindNull
+}
+
+func (n *Null) ToString() string {
+	return ""
+}
+
+func (n *Null) ToInt() (int, error) {
+	return 0, nil
+}
+
+func (n *Null) ToFloat() (float64, error) {
+	return 0, nil
+}
+
+func (n *Null) ToBool() (bool, error) {
+	return false, nil
+}
+
+func (n *Null) ToArray() (*Array, error) {
+	return NewArray(), nil
+}
+
+func (n *Null) Dump() string {
+	return "NULL"
+}
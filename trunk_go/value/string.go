@@ -2,7 +2,9 @@ package value
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 )
 
 type String struct {
@@ -13,21 +15,48 @@ func NewString(value string) *String {
 	return &String{value}
 }
 
+func (s *String) Kind() Kind {
+	return KindString
+}
+
 func (s *String) ToString() string {
 	return s.value
 }
 
-func (s *String) ToInt() int {
-	i, _ := strconv.Atoi(s.value)
-	return i
+// leadingIntPattern matches the optional sign and digit run PHP uses when
+// casting a string to int, e.g. "10abc" -> "10", "  -3x" -> "-3".
+var leadingIntPattern = regexp.MustCompile(`^[+-]?[0-9]+`)
+
+// leadingFloatPattern matches the leading numeric prefix PHP uses when
+// casting a string to float, e.g. "1.5e3abc" -> "1.5e3".
+var leadingFloatPattern = regexp.MustCompile(`^[+-]?([0-9]+\.[0-9]*|\.[0-9]+|[0-9]+)([eE][+-]?[0-9]+)?`)
+
+func (s *String) ToInt() (int, error) {
+	match := leadingIntPattern.FindString(strings.TrimSpace(s.value))
+	if match == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(match)
+}
+
+func (s *String) ToFloat() (float64, error) {
+	match := leadingFloatPattern.FindString(strings.TrimSpace(s.value))
+	if match == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseFloat(match, 64)
 }
 
-func (s *String) IsString() bool {
-	return true
+func (s *String) ToBool() (bool, error) {
+	return s.value != "" && s.value != "0", nil
 }
 
-func (s *String) IsInt() bool {
-	return false
+func (s *String) ToArray() (*Array, error) {
+	arr := NewArray()
+	arr.Push(s)
+	return arr, nil
 }
 
 func (s *String) Dump() string {
@@ -1,11 +1,13 @@
 package value
 
 type Value interface {
-	ToString() string
-	ToInt() int
+	Kind() Kind
 
-	IsString() bool
-	IsInt() bool
+	ToString() string
+	ToInt() (int, error)
+	ToFloat() (float64, error)
+	ToBool() (bool, error)
+	ToArray() (*Array, error)
 
 	Dump() string
 }